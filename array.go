@@ -0,0 +1,130 @@
+package ezjson
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// elemPath builds a human-readable path string for element idx of the array addressed by keys,
+// e.g. keys = {"data", "array"}, idx = 2 -> "data.array[2]". Used to produce diagnosable KeyErrors
+// for the typed array accessors below.
+func elemPath(keys []interface{}, idx int) string {
+	var sb strings.Builder
+	for _, k := range keys {
+		switch v := k.(type) {
+		case string:
+			if sb.Len() > 0 {
+				sb.WriteByte('.')
+			}
+			sb.WriteString(v)
+		case int:
+			fmt.Fprintf(&sb, "[%d]", v)
+		}
+	}
+	fmt.Fprintf(&sb, "[%d]", idx)
+	return sb.String()
+}
+
+// GetStringArray returns an array of strings contained in the "JSON" object intf using the given
+// (nested) keys. If any element is not a string, a *KeyError is returned whose Idx is the index of
+// the first non-conforming element and whose Key is the full path to that element.
+func GetStringArray(intf interface{}, keys ...interface{}) (res []string, err error) {
+	arr, err := GetArray(intf, keys...)
+	if err != nil {
+		return nil, err
+	}
+	res = make([]string, len(arr))
+	for i, v := range arr {
+		s, ok := v.(string)
+		if !ok {
+			return nil, &KeyError{"Array element is not of type string", i, elemPath(keys, i)}
+		}
+		res[i] = s
+	}
+	return res, nil
+}
+
+// GetIntArray returns an array of int64s contained in the "JSON" object intf using the given
+// (nested) keys. If any element is not a number, a *KeyError is returned whose Idx is the index of
+// the first non-conforming element and whose Key is the full path to that element.
+func GetIntArray(intf interface{}, keys ...interface{}) (res []int64, err error) {
+	arr, err := GetArray(intf, keys...)
+	if err != nil {
+		return nil, err
+	}
+	res = make([]int64, len(arr))
+	for i, v := range arr {
+		num, ok := v.(json.Number)
+		if !ok {
+			return nil, &KeyError{"Array element is not of type number", i, elemPath(keys, i)}
+		}
+		n, convErr := num.Int64()
+		if convErr != nil {
+			return nil, &KeyError{convErr.Error(), i, elemPath(keys, i)}
+		}
+		res[i] = n
+	}
+	return res, nil
+}
+
+// GetFloatArray returns an array of float64s contained in the "JSON" object intf using the given
+// (nested) keys. If any element is not a number, a *KeyError is returned whose Idx is the index of
+// the first non-conforming element and whose Key is the full path to that element.
+func GetFloatArray(intf interface{}, keys ...interface{}) (res []float64, err error) {
+	arr, err := GetArray(intf, keys...)
+	if err != nil {
+		return nil, err
+	}
+	res = make([]float64, len(arr))
+	for i, v := range arr {
+		num, ok := v.(json.Number)
+		if !ok {
+			return nil, &KeyError{"Array element is not of type number", i, elemPath(keys, i)}
+		}
+		f, convErr := num.Float64()
+		if convErr != nil {
+			return nil, &KeyError{convErr.Error(), i, elemPath(keys, i)}
+		}
+		res[i] = f
+	}
+	return res, nil
+}
+
+// GetBoolArray returns an array of bools contained in the "JSON" object intf using the given
+// (nested) keys. If any element is not a bool, a *KeyError is returned whose Idx is the index of
+// the first non-conforming element and whose Key is the full path to that element.
+func GetBoolArray(intf interface{}, keys ...interface{}) (res []bool, err error) {
+	arr, err := GetArray(intf, keys...)
+	if err != nil {
+		return nil, err
+	}
+	res = make([]bool, len(arr))
+	for i, v := range arr {
+		b, ok := v.(bool)
+		if !ok {
+			return nil, &KeyError{"Array element is not of type bool", i, elemPath(keys, i)}
+		}
+		res[i] = b
+	}
+	return res, nil
+}
+
+// GetObjectArray returns an array of objects contained in the "JSON" object intf using the given
+// (nested) keys. If any element is not an object, a *KeyError is returned whose Idx is the index of
+// the first non-conforming element and whose Key is the full path to that element.
+func GetObjectArray(intf interface{}, keys ...interface{}) (res []map[string]interface{}, err error) {
+	arr, err := GetArray(intf, keys...)
+	if err != nil {
+		return nil, err
+	}
+	res = make([]map[string]interface{}, len(arr))
+	for i, v := range arr {
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, &KeyError{"Array element is not of type object", i, elemPath(keys, i)}
+		}
+		res[i] = m
+	}
+	return res, nil
+}
@@ -0,0 +1,56 @@
+package ezjson_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/nieware/ezjson"
+)
+
+/*
+Reads the array property data.subData.array.*.str is not uniform, so this example reads the
+top-level numeric array as ints instead
+*/
+func ExampleGetIntArray() {
+	testData, _ := ezjson.DecodeString(testDataString)
+	res, err := ezjson.GetIntArray(testData, "array")
+	if err != nil {
+		fmt.Println(err.Error())
+		return
+	}
+	fmt.Println(res)
+	// Output: [1 2 3]
+}
+
+/*
+TestGetStringArrayWrongElementType checks that a non-string element produces a KeyError pointing
+at its position in the array
+*/
+func TestGetStringArrayWrongElementType(t *testing.T) {
+	testData, _ := ezjson.DecodeString(testDataString)
+	_, err := ezjson.GetStringArray(testData, "data", "subData", "array")
+	if err == nil {
+		t.Fatal("expected error for mixed-type array")
+	}
+	keyErr, ok := err.(*ezjson.KeyError)
+	if !ok {
+		t.Fatalf("expected *ezjson.KeyError, got %T", err)
+	}
+	if keyErr.Idx != 0 {
+		t.Fatalf("expected Idx 0 (first element is an object), got %d", keyErr.Idx)
+	}
+}
+
+/*
+TestGetObjectArray checks reading an array of objects
+*/
+func TestGetObjectArray(t *testing.T) {
+	testData, _ := ezjson.DecodeString(`{"items":[{"a":1},{"b":2}]}`)
+	res, err := ezjson.GetObjectArray(testData, "items")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res) != 2 {
+		t.Fatalf("expected 2 objects, got %d", len(res))
+	}
+}
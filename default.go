@@ -0,0 +1,223 @@
+package ezjson
+
+import "encoding/json"
+
+// isMissingOrNull classifies an error returned by one of the Get* functions as either "missing or
+// null" (no such key/index, or a null value with ErrorOnNull) or a genuine type mismatch. This lets
+// the *Or variants below swallow the former while the *OrErr variants still surface the latter.
+func isMissingOrNull(err error) bool {
+	if _, ok := err.(*NullError); ok {
+		return true
+	}
+	if ke, ok := err.(*KeyError); ok {
+		switch ke.Msg {
+		case "No object found", "No array found", "Object property not found", "Array index out of bounds":
+			return true
+		}
+	}
+	return false
+}
+
+// withErrorOnNull prepends ErrorOnNull to keys, so that the underlying GetPropertyWithType call
+// reports a null value as a *NullError instead of silently returning a nil/zero-value result.
+func withErrorOnNull(keys []interface{}) []interface{} {
+	return append([]interface{}{ErrorOnNull}, keys...)
+}
+
+// GetStringOr returns the string property addressed by keys, or def if it's missing, null, or not
+// a string.
+func GetStringOr(intf interface{}, def string, keys ...interface{}) string {
+	res, err := GetString(intf, withErrorOnNull(keys)...)
+	if err != nil {
+		return def
+	}
+	return res
+}
+
+// GetStringOrErr returns the string property addressed by keys, or def if it's missing or null.
+// Unlike GetStringOr, a type mismatch is not swallowed: err is non-nil and res is def.
+func GetStringOrErr(intf interface{}, def string, keys ...interface{}) (res string, err error) {
+	res, err = GetString(intf, withErrorOnNull(keys)...)
+	if err == nil {
+		return res, nil
+	}
+	if isMissingOrNull(err) {
+		return def, nil
+	}
+	return def, err
+}
+
+// MustGetString returns the string property addressed by keys, panicking with the original error
+// if it's missing, null, or not a string. Useful in test fixtures and config loaders.
+func MustGetString(intf interface{}, keys ...interface{}) string {
+	res, err := GetString(intf, withErrorOnNull(keys)...)
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// GetIntOr returns the int64 property addressed by keys, or def if it's missing, null, or not a
+// number.
+func GetIntOr(intf interface{}, def int64, keys ...interface{}) int64 {
+	res, err := GetInt(intf, withErrorOnNull(keys)...)
+	if err != nil {
+		return def
+	}
+	return res
+}
+
+// GetIntOrErr returns the int64 property addressed by keys, or def if it's missing or null. Unlike
+// GetIntOr, a type mismatch is not swallowed: err is non-nil and res is def.
+func GetIntOrErr(intf interface{}, def int64, keys ...interface{}) (res int64, err error) {
+	res, err = GetInt(intf, withErrorOnNull(keys)...)
+	if err == nil {
+		return res, nil
+	}
+	if isMissingOrNull(err) {
+		return def, nil
+	}
+	return def, err
+}
+
+// MustGetInt returns the int64 property addressed by keys, panicking with the original error if
+// it's missing, null, or not a number. Useful in test fixtures and config loaders.
+func MustGetInt(intf interface{}, keys ...interface{}) int64 {
+	res, err := GetInt(intf, withErrorOnNull(keys)...)
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// GetFloatOr returns the float64 property addressed by keys, or def if it's missing, null, or not
+// a number.
+func GetFloatOr(intf interface{}, def float64, keys ...interface{}) float64 {
+	res, err := GetFloat(intf, withErrorOnNull(keys)...)
+	if err != nil {
+		return def
+	}
+	return res
+}
+
+// GetFloatOrErr returns the float64 property addressed by keys, or def if it's missing or null.
+// Unlike GetFloatOr, a type mismatch is not swallowed: err is non-nil and res is def.
+func GetFloatOrErr(intf interface{}, def float64, keys ...interface{}) (res float64, err error) {
+	res, err = GetFloat(intf, withErrorOnNull(keys)...)
+	if err == nil {
+		return res, nil
+	}
+	if isMissingOrNull(err) {
+		return def, nil
+	}
+	return def, err
+}
+
+// MustGetFloat returns the float64 property addressed by keys, panicking with the original error
+// if it's missing, null, or not a number. Useful in test fixtures and config loaders.
+func MustGetFloat(intf interface{}, keys ...interface{}) float64 {
+	res, err := GetFloat(intf, withErrorOnNull(keys)...)
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// GetBoolOr returns the bool property addressed by keys, or def if it's missing, null, or not a
+// bool.
+func GetBoolOr(intf interface{}, def bool, keys ...interface{}) bool {
+	res, err := GetBool(intf, withErrorOnNull(keys)...)
+	if err != nil {
+		return def
+	}
+	return res
+}
+
+// GetBoolOrErr returns the bool property addressed by keys, or def if it's missing or null. Unlike
+// GetBoolOr, a type mismatch is not swallowed: err is non-nil and res is def.
+func GetBoolOrErr(intf interface{}, def bool, keys ...interface{}) (res bool, err error) {
+	res, err = GetBool(intf, withErrorOnNull(keys)...)
+	if err == nil {
+		return res, nil
+	}
+	if isMissingOrNull(err) {
+		return def, nil
+	}
+	return def, err
+}
+
+// MustGetBool returns the bool property addressed by keys, panicking with the original error if
+// it's missing, null, or not a bool. Useful in test fixtures and config loaders.
+func MustGetBool(intf interface{}, keys ...interface{}) bool {
+	res, err := GetBool(intf, withErrorOnNull(keys)...)
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// GetNumberOr returns the json.Number property addressed by keys, or def if it's missing, null, or
+// not a number.
+func GetNumberOr(intf interface{}, def json.Number, keys ...interface{}) json.Number {
+	res, err := GetNumber(intf, withErrorOnNull(keys)...)
+	if err != nil {
+		return def
+	}
+	return res
+}
+
+// GetNumberOrErr returns the json.Number property addressed by keys, or def if it's missing or
+// null. Unlike GetNumberOr, a type mismatch is not swallowed: err is non-nil and res is def.
+func GetNumberOrErr(intf interface{}, def json.Number, keys ...interface{}) (res json.Number, err error) {
+	res, err = GetNumber(intf, withErrorOnNull(keys)...)
+	if err == nil {
+		return res, nil
+	}
+	if isMissingOrNull(err) {
+		return def, nil
+	}
+	return def, err
+}
+
+// MustGetNumber returns the json.Number property addressed by keys, panicking with the original
+// error if it's missing, null, or not a number. Useful in test fixtures and config loaders.
+func MustGetNumber(intf interface{}, keys ...interface{}) json.Number {
+	res, err := GetNumber(intf, withErrorOnNull(keys)...)
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
+
+// GetArrayOr returns the array property addressed by keys, or def if it's missing, null, or not an
+// array.
+func GetArrayOr(intf interface{}, def []interface{}, keys ...interface{}) []interface{} {
+	res, err := GetArray(intf, withErrorOnNull(keys)...)
+	if err != nil {
+		return def
+	}
+	return res
+}
+
+// GetArrayOrErr returns the array property addressed by keys, or def if it's missing or null.
+// Unlike GetArrayOr, a type mismatch is not swallowed: err is non-nil and res is def.
+func GetArrayOrErr(intf interface{}, def []interface{}, keys ...interface{}) (res []interface{}, err error) {
+	res, err = GetArray(intf, withErrorOnNull(keys)...)
+	if err == nil {
+		return res, nil
+	}
+	if isMissingOrNull(err) {
+		return def, nil
+	}
+	return def, err
+}
+
+// MustGetArray returns the array property addressed by keys, panicking with the original error if
+// it's missing, null, or not an array. Useful in test fixtures and config loaders.
+func MustGetArray(intf interface{}, keys ...interface{}) []interface{} {
+	res, err := GetArray(intf, withErrorOnNull(keys)...)
+	if err != nil {
+		panic(err)
+	}
+	return res
+}
@@ -0,0 +1,276 @@
+package ezjson_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/nieware/ezjson"
+)
+
+/*
+TestGetStringOr checks that GetStringOr falls back to the default for a missing key and a type
+mismatch alike
+*/
+func TestGetStringOr(t *testing.T) {
+	testData, _ := ezjson.DecodeString(testDataString)
+
+	if res := ezjson.GetStringOr(testData, "fallback", "data", "inexistentString"); res != "fallback" {
+		t.Fatalf("expected 'fallback' for missing key, got %q", res)
+	}
+	if res := ezjson.GetStringOr(testData, "fallback", "data", "int"); res != "fallback" {
+		t.Fatalf("expected 'fallback' for type mismatch, got %q", res)
+	}
+	if res := ezjson.GetStringOr(testData, "fallback", "data", "str"); res != "string in data" {
+		t.Fatalf("expected the actual value, got %q", res)
+	}
+}
+
+/*
+TestGetStringOrErr checks that GetStringOrErr swallows missing keys but surfaces type mismatches
+*/
+func TestGetStringOrErr(t *testing.T) {
+	testData, _ := ezjson.DecodeString(testDataString)
+
+	res, err := ezjson.GetStringOrErr(testData, "fallback", "data", "inexistentString")
+	if err != nil {
+		t.Fatalf("expected no error for missing key, got %v", err)
+	}
+	if res != "fallback" {
+		t.Fatalf("expected 'fallback', got %q", res)
+	}
+
+	_, err = ezjson.GetStringOrErr(testData, "fallback", "data", "int")
+	if err == nil {
+		t.Fatal("expected an error for a type mismatch")
+	}
+}
+
+/*
+TestMustGetStringPanics checks that MustGetString panics with the original error
+*/
+func TestMustGetStringPanics(t *testing.T) {
+	testData, _ := ezjson.DecodeString(testDataString)
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatal("expected MustGetString to panic")
+		}
+	}()
+	ezjson.MustGetString(testData, "data", "inexistentString")
+}
+
+/*
+TestMustGetInt checks the happy path of MustGetInt
+*/
+func TestMustGetInt(t *testing.T) {
+	testData, _ := ezjson.DecodeString(testDataString)
+	if res := ezjson.MustGetInt(testData, "data", "int"); res != 123 {
+		t.Fatalf("expected 123, got %d", res)
+	}
+}
+
+/*
+TestGetOrNullField checks that a null value falls back to def (rather than a zero value) for the
+String, Int, Float, Bool and Array *Or variants, and that GetIntOrErr returns a nil error for it
+rather than leaking a parse error
+*/
+func TestGetOrNullField(t *testing.T) {
+	testData, _ := ezjson.DecodeString(testDataString)
+
+	if res := ezjson.GetStringOr(testData, "fallback", "data", "nullField"); res != "fallback" {
+		t.Fatalf("expected 'fallback' for null field, got %q", res)
+	}
+	if res := ezjson.GetIntOr(testData, 99, "data", "nullField"); res != 99 {
+		t.Fatalf("expected 99 for null field, got %d", res)
+	}
+	if res := ezjson.GetFloatOr(testData, 9.9, "data", "nullField"); res != 9.9 {
+		t.Fatalf("expected 9.9 for null field, got %v", res)
+	}
+	if res := ezjson.GetBoolOr(testData, true, "data", "nullField"); res != true {
+		t.Fatalf("expected true for null field, got %v", res)
+	}
+	if res := ezjson.GetArrayOr(testData, []interface{}{1}, "data", "nullField"); len(res) != 1 {
+		t.Fatalf("expected the default array for null field, got %v", res)
+	}
+
+	res, err := ezjson.GetIntOrErr(testData, 99, "data", "nullField")
+	if err != nil {
+		t.Fatalf("expected no error for null field, got %v", err)
+	}
+	if res != 99 {
+		t.Fatalf("expected 99 for null field, got %d", res)
+	}
+}
+
+/*
+TestMustGetStringPanicsOnNull checks that Must* also treats a null value as a failure, not a
+silent zero value
+*/
+func TestMustGetStringPanicsOnNull(t *testing.T) {
+	testData, _ := ezjson.DecodeString(testDataString)
+
+	defer func() {
+		if r := recover(); r == nil {
+			t.Fatal("expected MustGetString to panic on a null field")
+		}
+	}()
+	ezjson.MustGetString(testData, "data", "nullField")
+}
+
+/*
+TestGetBoolOrErr checks that GetBoolOrErr swallows missing keys but surfaces type mismatches
+*/
+func TestGetBoolOrErr(t *testing.T) {
+	testData, _ := ezjson.DecodeString(testDataString)
+
+	res, err := ezjson.GetBoolOrErr(testData, true, "data", "inexistentBool")
+	if err != nil {
+		t.Fatalf("expected no error for missing key, got %v", err)
+	}
+	if res != true {
+		t.Fatalf("expected true, got %v", res)
+	}
+
+	_, err = ezjson.GetBoolOrErr(testData, true, "data", "str")
+	if err == nil {
+		t.Fatal("expected an error for a type mismatch")
+	}
+}
+
+/*
+TestGetFloatOrErr checks that GetFloatOrErr swallows missing keys but surfaces type mismatches
+*/
+func TestGetFloatOrErr(t *testing.T) {
+	testData, _ := ezjson.DecodeString(testDataString)
+
+	res, err := ezjson.GetFloatOrErr(testData, 9.9, "data", "inexistentFloat")
+	if err != nil {
+		t.Fatalf("expected no error for missing key, got %v", err)
+	}
+	if res != 9.9 {
+		t.Fatalf("expected 9.9, got %v", res)
+	}
+
+	_, err = ezjson.GetFloatOrErr(testData, 9.9, "data", "str")
+	if err == nil {
+		t.Fatal("expected an error for a type mismatch")
+	}
+}
+
+/*
+TestGetNumberOrErr checks that GetNumberOrErr swallows missing keys but surfaces type mismatches
+*/
+func TestGetNumberOrErr(t *testing.T) {
+	testData, _ := ezjson.DecodeString(testDataString)
+	def := json.Number("42")
+
+	res, err := ezjson.GetNumberOrErr(testData, def, "data", "inexistentNumber")
+	if err != nil {
+		t.Fatalf("expected no error for missing key, got %v", err)
+	}
+	if res != def {
+		t.Fatalf("expected %v, got %v", def, res)
+	}
+
+	_, err = ezjson.GetNumberOrErr(testData, def, "data", "str")
+	if err == nil {
+		t.Fatal("expected an error for a type mismatch")
+	}
+}
+
+/*
+TestGetArrayOrErr checks that GetArrayOrErr swallows missing keys but surfaces type mismatches
+*/
+func TestGetArrayOrErr(t *testing.T) {
+	testData, _ := ezjson.DecodeString(testDataString)
+	def := []interface{}{1}
+
+	res, err := ezjson.GetArrayOrErr(testData, def, "data", "inexistentArray")
+	if err != nil {
+		t.Fatalf("expected no error for missing key, got %v", err)
+	}
+	if len(res) != 1 {
+		t.Fatalf("expected the default array, got %v", res)
+	}
+
+	_, err = ezjson.GetArrayOrErr(testData, def, "data", "str")
+	if err == nil {
+		t.Fatal("expected an error for a type mismatch")
+	}
+}
+
+/*
+TestMustGetBool checks the happy path of MustGetBool and that it panics for a missing key
+*/
+func TestMustGetBool(t *testing.T) {
+	testData, _ := ezjson.DecodeString(testDataString)
+	if res := ezjson.MustGetBool(testData, "data", "subData", "bool"); res != false {
+		t.Fatalf("expected false, got %v", res)
+	}
+
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Fatal("expected MustGetBool to panic for a missing key")
+			}
+		}()
+		ezjson.MustGetBool(testData, "data", "inexistentBool")
+	}()
+}
+
+/*
+TestMustGetFloat checks the happy path of MustGetFloat and that it panics for a missing key
+*/
+func TestMustGetFloat(t *testing.T) {
+	testData, _ := ezjson.DecodeString(testDataString)
+	if res := ezjson.MustGetFloat(testData, "data", "subData", "array", 2); res != 12.34 {
+		t.Fatalf("expected 12.34, got %v", res)
+	}
+
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Fatal("expected MustGetFloat to panic for a missing key")
+			}
+		}()
+		ezjson.MustGetFloat(testData, "data", "inexistentFloat")
+	}()
+}
+
+/*
+TestMustGetNumber checks the happy path of MustGetNumber and that it panics for a missing key
+*/
+func TestMustGetNumber(t *testing.T) {
+	testData, _ := ezjson.DecodeString(testDataString)
+	if res := ezjson.MustGetNumber(testData, "data", "int"); res.String() != "123" {
+		t.Fatalf("expected 123, got %v", res)
+	}
+
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Fatal("expected MustGetNumber to panic for a missing key")
+			}
+		}()
+		ezjson.MustGetNumber(testData, "data", "inexistentNumber")
+	}()
+}
+
+/*
+TestMustGetArray checks the happy path of MustGetArray and that it panics for a missing key
+*/
+func TestMustGetArray(t *testing.T) {
+	testData, _ := ezjson.DecodeString(testDataString)
+	if res := ezjson.MustGetArray(testData, "array"); len(res) != 3 {
+		t.Fatalf("expected 3 elements, got %v", res)
+	}
+
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Fatal("expected MustGetArray to panic for a missing key")
+			}
+		}()
+		ezjson.MustGetArray(testData, "data", "inexistentArray")
+	}()
+}
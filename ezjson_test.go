@@ -23,7 +23,8 @@ var testDataString = `
 			"bool":false
 		},
 		"int":123,
-		"str":"string in data"
+		"str":"string in data",
+		"nullField":null
 	},
 	"moreData":{
 		"str":"string in moreData"
@@ -53,12 +54,12 @@ Reads and prints the property data.subData from testData
 */
 func ExampleGetProperty() {
 	testData, _ := ezjson.DecodeString(testDataString)
-	res, skey, err := ezjson.GetProperty(testData, "data", "subData")
+	res, err := ezjson.GetProperty(testData, "data", "subData")
 	if err != nil {
 		fmt.Println(err.Error())
 		return
 	}
-	fmt.Printf("'%s': %#v\n", skey, res)
+	fmt.Printf("'%s': %#v\n", "subData", res)
 	// this can't be used as a test function (yet?) because the sort order when printing maps is undefined
 }
 
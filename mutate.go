@@ -0,0 +1,140 @@
+package ezjson
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// SetProperty sets a property in "JSON" object root using the given (nested) keys, creating
+// intermediate map[string]interface{} values for missing string keys along the way. An int key is
+// only valid if the array at that level already exists; it may address an existing element or,
+// if it equals the array's current length, extend the array by one element. Because slices can't
+// be modified in place through an interface{}, SetProperty returns the (possibly new) root, which
+// the caller must reassign.
+func SetProperty(root interface{}, value interface{}, keys ...interface{}) (interface{}, error) {
+	if len(keys) == 0 {
+		return value, nil
+	}
+	return setProperty(root, keys, 0, value)
+}
+
+func setProperty(node interface{}, keys []interface{}, idx int, value interface{}) (interface{}, error) {
+	last := idx == len(keys)-1
+
+	switch k := keys[idx].(type) {
+	case string:
+		m, isMap := node.(map[string]interface{})
+		if !isMap {
+			if node != nil {
+				return nil, &KeyError{"No object found", idx, k}
+			}
+			m = map[string]interface{}{}
+		}
+		if last {
+			m[k] = value
+			return m, nil
+		}
+		child, err := setProperty(m[k], keys, idx+1, value)
+		if err != nil {
+			return nil, err
+		}
+		m[k] = child
+		return m, nil
+	case int:
+		skey := strconv.Itoa(k)
+		arr, isArray := node.([]interface{})
+		if !isArray {
+			return nil, &KeyError{"No array found", idx, skey}
+		}
+		if k < 0 {
+			return nil, &KeyError{"Array index out of bounds", idx, skey}
+		}
+		if k > len(arr) {
+			return nil, &KeyError{"Array index would leave a gap", idx, skey}
+		}
+		if k == len(arr) {
+			arr = append(arr, nil)
+		}
+		if last {
+			arr[k] = value
+			return arr, nil
+		}
+		child, err := setProperty(arr[k], keys, idx+1, value)
+		if err != nil {
+			return nil, err
+		}
+		arr[k] = child
+		return arr, nil
+	default:
+		return nil, &KeyError{"Not int or string", idx, fmt.Sprint("#v", k)}
+	}
+}
+
+// DeleteProperty removes a property from "JSON" object root using the given (nested) keys, using
+// the same key semantics as GetProperty. Because slices can't be modified in place through an
+// interface{}, DeleteProperty returns the (possibly new) root, which the caller must reassign.
+func DeleteProperty(root interface{}, keys ...interface{}) (interface{}, error) {
+	if len(keys) == 0 {
+		return nil, &KeyError{"DeleteProperty requires at least one key", 0, ""}
+	}
+	return deleteProperty(root, keys, 0)
+}
+
+func deleteProperty(node interface{}, keys []interface{}, idx int) (interface{}, error) {
+	last := idx == len(keys)-1
+
+	switch k := keys[idx].(type) {
+	case string:
+		m, isMap := node.(map[string]interface{})
+		if !isMap {
+			return nil, &KeyError{"No object found", idx, k}
+		}
+		child, ok := m[k]
+		if !ok {
+			return nil, &KeyError{"Object property not found", idx, k}
+		}
+		if last {
+			delete(m, k)
+			return m, nil
+		}
+		newChild, err := deleteProperty(child, keys, idx+1)
+		if err != nil {
+			return nil, err
+		}
+		m[k] = newChild
+		return m, nil
+	case int:
+		skey := strconv.Itoa(k)
+		arr, isArray := node.([]interface{})
+		if !isArray {
+			return nil, &KeyError{"No array found", idx, skey}
+		}
+		if k < 0 || k >= len(arr) {
+			return nil, &KeyError{"Array index out of bounds", idx, skey}
+		}
+		if last {
+			return append(arr[:k], arr[k+1:]...), nil
+		}
+		newChild, err := deleteProperty(arr[k], keys, idx+1)
+		if err != nil {
+			return nil, err
+		}
+		arr[k] = newChild
+		return arr, nil
+	default:
+		return nil, &KeyError{"Not int or string", idx, fmt.Sprint("#v", k)}
+	}
+}
+
+// Encode marshals v back to JSON. json.Number values (as produced by DecodeBytes/DecodeString)
+// are preserved losslessly as numbers rather than being converted to float64.
+func Encode(v interface{}) ([]byte, error) {
+	return json.Marshal(v)
+}
+
+// EncodeIndent marshals v back to JSON with indentation, see json.MarshalIndent. json.Number
+// values are preserved losslessly as numbers rather than being converted to float64.
+func EncodeIndent(v interface{}, prefix, indent string) ([]byte, error) {
+	return json.MarshalIndent(v, prefix, indent)
+}
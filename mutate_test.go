@@ -0,0 +1,126 @@
+package ezjson_test
+
+import (
+	"testing"
+
+	"github.com/nieware/ezjson"
+)
+
+/*
+TestSetPropertyExisting checks overwriting an existing property
+*/
+func TestSetPropertyExisting(t *testing.T) {
+	testData, _ := ezjson.DecodeString(testDataString)
+	root, err := ezjson.SetProperty(testData, "changed", "data", "str")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := ezjson.GetString(root, "data", "str")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res != "changed" {
+		t.Fatalf("expected 'changed', got %q", res)
+	}
+}
+
+/*
+TestSetPropertyCreatesIntermediateMaps checks that missing object keys are created on the fly
+*/
+func TestSetPropertyCreatesIntermediateMaps(t *testing.T) {
+	root, err := ezjson.SetProperty(map[string]interface{}{}, "value", "a", "b", "c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := ezjson.GetString(root, "a", "b", "c")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res != "value" {
+		t.Fatalf("expected 'value', got %q", res)
+	}
+}
+
+/*
+TestSetPropertyExtendsArray checks that setting the index equal to the array's length extends it
+*/
+func TestSetPropertyExtendsArray(t *testing.T) {
+	testData, _ := ezjson.DecodeString(testDataString)
+	root, err := ezjson.SetProperty(testData, float64(4), "array", 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	arr, err := ezjson.GetArray(root, "array")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(arr) != 4 {
+		t.Fatalf("expected array of length 4, got %d", len(arr))
+	}
+}
+
+/*
+TestSetPropertyGap checks that extending an array past its current length returns an error
+*/
+func TestSetPropertyGap(t *testing.T) {
+	testData, _ := ezjson.DecodeString(testDataString)
+	_, err := ezjson.SetProperty(testData, "x", "array", 10)
+	if err == nil {
+		t.Fatal("expected error for index that would leave a gap")
+	}
+}
+
+/*
+TestDeleteProperty checks deleting an object property
+*/
+func TestDeleteProperty(t *testing.T) {
+	testData, _ := ezjson.DecodeString(testDataString)
+	root, err := ezjson.DeleteProperty(testData, "data", "str")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = ezjson.GetString(root, "data", "str")
+	if err == nil {
+		t.Fatal("expected property to be gone")
+	}
+}
+
+/*
+TestDeletePropertyArrayElement checks deleting an array element shifts later elements down
+*/
+func TestDeletePropertyArrayElement(t *testing.T) {
+	testData, _ := ezjson.DecodeString(testDataString)
+	root, err := ezjson.DeleteProperty(testData, "array", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := ezjson.GetInt(root, "array", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res != 2 {
+		t.Fatalf("expected 2, got %d", res)
+	}
+}
+
+/*
+TestEncodeRoundTrip checks that decoding and re-encoding preserves numbers losslessly
+*/
+func TestEncodeRoundTrip(t *testing.T) {
+	testData, _ := ezjson.DecodeString(testDataString)
+	out, err := ezjson.Encode(testData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	roundTripped, err := ezjson.DecodeBytes(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := ezjson.GetFloat(roundTripped, "data", "subData", "array", 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res != 12.34 {
+		t.Fatalf("expected 12.34, got %v", res)
+	}
+}
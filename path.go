@@ -0,0 +1,145 @@
+package ezjson
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// PathError is a custom error type which is returned when a path string passed to one of the
+// *ByPath functions can't be parsed. Offset points at the character in Path where parsing failed.
+type PathError struct {
+	Msg    string // the error message
+	Offset int    // the character offset in Path where the error occurred
+	Path   string // the original path string
+}
+
+func (e *PathError) Error() string {
+	return fmt.Sprintf("%s (@ offset %d in path %q)", e.Msg, e.Offset, e.Path)
+}
+
+// parsePath tokenizes a JSONPath-style path string into the []interface{} key sequence used by
+// GetPropertyWithType. Supported syntax:
+//   - dotted object keys: data.subData
+//   - bracketed array indices: array[0]
+//   - quoted keys (for keys containing dots or brackets): data["weird.key"]
+//   - a trailing wildcard "*", which stops the key sequence one segment short so the caller gets
+//     back the whole child slice/map instead of a single element
+func parsePath(path string) (keys []interface{}, err error) {
+	i, n := 0, len(path)
+	for i < n {
+		switch c := path[i]; {
+		case c == '.':
+			i++
+		case c == '[':
+			j := i + 1
+			if j < n && path[j] == '"' {
+				end := -1
+				for k := j + 1; k < n; k++ {
+					if path[k] == '"' && path[k-1] != '\\' {
+						end = k
+						break
+					}
+				}
+				if end == -1 {
+					return nil, &PathError{"unterminated quoted key", j, path}
+				}
+				if end+1 >= n || path[end+1] != ']' {
+					return nil, &PathError{"expected ']' after quoted key", end + 1, path}
+				}
+				keys = append(keys, strings.ReplaceAll(path[j+1:end], `\"`, `"`))
+				i = end + 2
+			} else {
+				end := strings.IndexByte(path[j:], ']')
+				if end == -1 {
+					return nil, &PathError{"unterminated '['", i, path}
+				}
+				end += j
+				idx, convErr := strconv.Atoi(path[j:end])
+				if convErr != nil {
+					return nil, &PathError{"invalid array index", j, path}
+				}
+				keys = append(keys, idx)
+				i = end + 1
+			}
+		case c == '*':
+			if i != n-1 {
+				return nil, &PathError{"wildcard '*' is only allowed as the last path segment", i, path}
+			}
+			i++
+		default:
+			j := i
+			for j < n && path[j] != '.' && path[j] != '[' {
+				j++
+			}
+			keys = append(keys, path[i:j])
+			i = j
+		}
+	}
+	return keys, nil
+}
+
+// GetByPath returns a property from "JSON" object intf using a single path string instead of a
+// nested key sequence, e.g. "data.subData.array[0].str". See parsePath for the supported syntax.
+func GetByPath(intf interface{}, path string) (res interface{}, err error) {
+	keys, err := parsePath(path)
+	if err != nil {
+		return nil, err
+	}
+	return GetProperty(intf, keys...)
+}
+
+// GetArrayByPath returns an array contained in the "JSON" object intf, addressed by path string.
+func GetArrayByPath(intf interface{}, path string) (res []interface{}, err error) {
+	keys, err := parsePath(path)
+	if err != nil {
+		return nil, err
+	}
+	return GetArray(intf, keys...)
+}
+
+// GetNumberByPath returns a Number contained in the "JSON" object intf, addressed by path string.
+func GetNumberByPath(intf interface{}, path string) (res json.Number, err error) {
+	keys, err := parsePath(path)
+	if err != nil {
+		return
+	}
+	return GetNumber(intf, keys...)
+}
+
+// GetIntByPath returns an int64 contained in the "JSON" object intf, addressed by path string.
+func GetIntByPath(intf interface{}, path string) (res int64, err error) {
+	keys, err := parsePath(path)
+	if err != nil {
+		return
+	}
+	return GetInt(intf, keys...)
+}
+
+// GetFloatByPath returns a float64 contained in the "JSON" object intf, addressed by path string.
+func GetFloatByPath(intf interface{}, path string) (res float64, err error) {
+	keys, err := parsePath(path)
+	if err != nil {
+		return
+	}
+	return GetFloat(intf, keys...)
+}
+
+// GetStringByPath returns a string contained in the "JSON" object intf, addressed by path string.
+func GetStringByPath(intf interface{}, path string) (res string, err error) {
+	keys, err := parsePath(path)
+	if err != nil {
+		return
+	}
+	return GetString(intf, keys...)
+}
+
+// GetBoolByPath returns a bool contained in the "JSON" object intf, addressed by path string.
+func GetBoolByPath(intf interface{}, path string) (res bool, err error) {
+	keys, err := parsePath(path)
+	if err != nil {
+		return
+	}
+	return GetBool(intf, keys...)
+}
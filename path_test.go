@@ -0,0 +1,81 @@
+package ezjson_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/nieware/ezjson"
+)
+
+/*
+Reads the deeply nested string property data.subData.array[0].str from testData using a path string
+*/
+func ExampleGetStringByPath() {
+	testData, _ := ezjson.DecodeString(testDataString)
+	res, err := ezjson.GetStringByPath(testData, "data.subData.array[0].str")
+	if err != nil {
+		fmt.Println(err.Error())
+		return
+	}
+	fmt.Println(res)
+	// Output: a string
+}
+
+/*
+Reads int property array[1] from testData using a path string
+*/
+func ExampleGetIntByPath() {
+	testData, _ := ezjson.DecodeString(testDataString)
+	res, err := ezjson.GetIntByPath(testData, "array[1]")
+	if err != nil {
+		fmt.Println(err.Error())
+		return
+	}
+	fmt.Println(res)
+	// Output: 2
+}
+
+/*
+TestGetByPathQuotedKey checks reading a key containing a dot via a quoted path segment
+*/
+func TestGetByPathQuotedKey(t *testing.T) {
+	testData, _ := ezjson.DecodeString(`{"data":{"weird.key":"value"}}`)
+	res, err := ezjson.GetStringByPath(testData, `data["weird.key"]`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res != "value" {
+		t.Fatalf("expected 'value', got %q", res)
+	}
+}
+
+/*
+TestGetByPathWildcard checks that a trailing wildcard returns the whole child node
+*/
+func TestGetByPathWildcard(t *testing.T) {
+	testData, _ := ezjson.DecodeString(testDataString)
+	res, err := ezjson.GetByPath(testData, "data.subData.array.*")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := res.([]interface{}); !ok {
+		t.Fatalf("expected []interface{}, got %T", res)
+	}
+}
+
+/*
+TestGetByPathInvalidPath checks that a malformed path produces a PathError
+*/
+func TestGetByPathInvalidPath(t *testing.T) {
+	testData, _ := ezjson.DecodeString(testDataString)
+
+	_, err := ezjson.GetByPath(testData, "data.subData.array[0")
+	if err == nil {
+		t.Fatal("expected error for unterminated '['")
+	}
+
+	_, err = ezjson.GetByPath(testData, "data.*.str")
+	if err == nil {
+		t.Fatal("expected error for wildcard that isn't the last segment")
+	}
+}
@@ -0,0 +1,158 @@
+package ezjson
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+)
+
+// Decoder wraps a *json.Decoder, adding the ability to stream a nested array one element at a
+// time instead of materializing the whole document into a map[string]interface{}. This is useful
+// for large JSON bodies (e.g. newline-delimited JSON or big arrays of events from an HTTP response)
+// where loading everything into memory at once isn't practical.
+type Decoder struct {
+	d *json.Decoder
+}
+
+// NewDecoder returns a new Decoder reading from r (using json.Number for numeric values).
+func NewDecoder(r io.Reader) *Decoder {
+	d := json.NewDecoder(r)
+	d.UseNumber()
+	return &Decoder{d: d}
+}
+
+// Token returns the next JSON token in the input stream, see json.Decoder.Token.
+func (dec *Decoder) Token() (json.Token, error) {
+	return dec.d.Token()
+}
+
+// More reports whether there is another element in the current array or object being parsed,
+// see json.Decoder.More.
+func (dec *Decoder) More() bool {
+	return dec.d.More()
+}
+
+// DecodeReader decodes JSON data from an io.Reader (using json.Number) in one go. For large
+// documents where only a nested array needs to be processed, use NewDecoder and StreamArray
+// instead.
+func DecodeReader(r io.Reader) (data interface{}, err error) {
+	d := json.NewDecoder(r)
+	d.UseNumber()
+	err = d.Decode(&data)
+	return
+}
+
+// skipValue consumes and discards one complete JSON value (scalar, object or array) from dec
+// without materializing it, so that sibling keys/elements can be skipped cheaply while seeking.
+func skipValue(dec *json.Decoder) error {
+	tok, err := dec.Token()
+	if err != nil {
+		return err
+	}
+	delim, ok := tok.(json.Delim)
+	if !ok || (delim != '{' && delim != '[') {
+		return nil
+	}
+	for dec.More() {
+		if delim == '{' {
+			if _, err := dec.Token(); err != nil { // the key
+				return err
+			}
+		}
+		if err := skipValue(dec); err != nil {
+			return err
+		}
+	}
+	_, err = dec.Token() // the closing delimiter
+	return err
+}
+
+// seek advances dec token-by-token until it is positioned right before the value identified by
+// keys, using the same key semantics as GetPropertyWithType (string keys for object properties,
+// int keys for array indices).
+func (dec *Decoder) seek(keys []interface{}) error {
+	for idx, key := range keys {
+		switch k := key.(type) {
+		case string:
+			tok, err := dec.d.Token()
+			if err != nil {
+				return err
+			}
+			if delim, ok := tok.(json.Delim); !ok || delim != '{' {
+				return &KeyError{"No object found", idx, k}
+			}
+			found := false
+			for dec.d.More() {
+				keyTok, err := dec.d.Token()
+				if err != nil {
+					return err
+				}
+				if keyStr, _ := keyTok.(string); keyStr == k {
+					found = true
+					break
+				}
+				if err := skipValue(dec.d); err != nil {
+					return err
+				}
+			}
+			if !found {
+				return &KeyError{"Object property not found", idx, k}
+			}
+		case int:
+			skey := strconv.Itoa(k)
+			tok, err := dec.d.Token()
+			if err != nil {
+				return err
+			}
+			if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+				return &KeyError{"No array found", idx, skey}
+			}
+			found := false
+			for i := 0; dec.d.More(); i++ {
+				if i == k {
+					found = true
+					break
+				}
+				if err := skipValue(dec.d); err != nil {
+					return err
+				}
+			}
+			if !found {
+				return &KeyError{"Array index out of bounds", idx, skey}
+			}
+		default:
+			return &KeyError{"Not int or string", idx, fmt.Sprint("#v", k)}
+		}
+	}
+	return nil
+}
+
+// StreamArray seeks to the array nested at keys and calls fn once per element, decoding each
+// element individually so the array is never fully materialized in memory. If fn returns an
+// error, iteration stops and a *KeyError is returned whose Msg is that error's message and whose
+// Idx and Key identify the index of the element that caused it; the original error value itself
+// is not kept, so it can't be unwrapped.
+func (dec *Decoder) StreamArray(fn func(idx int, v interface{}) error, keys ...interface{}) error {
+	if err := dec.seek(keys); err != nil {
+		return err
+	}
+	tok, err := dec.d.Token()
+	if err != nil {
+		return err
+	}
+	if delim, ok := tok.(json.Delim); !ok || delim != '[' {
+		return &KeyError{"No array found", len(keys), "*"}
+	}
+	for idx := 0; dec.d.More(); idx++ {
+		var v interface{}
+		if err := dec.d.Decode(&v); err != nil {
+			return err
+		}
+		if err := fn(idx, v); err != nil {
+			return &KeyError{err.Error(), idx, strconv.Itoa(idx)}
+		}
+	}
+	_, err = dec.d.Token() // the closing ']'
+	return err
+}
@@ -0,0 +1,72 @@
+package ezjson_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/nieware/ezjson"
+)
+
+/*
+TestDecoderStreamArray checks that StreamArray visits every element of a nested array in order
+*/
+func TestDecoderStreamArray(t *testing.T) {
+	dec := ezjson.NewDecoder(strings.NewReader(testDataString))
+
+	var seen []int
+	err := dec.StreamArray(func(idx int, v interface{}) error {
+		seen = append(seen, idx)
+		return nil
+	}, "array")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(seen) != 3 {
+		t.Fatalf("expected 3 elements, got %d", len(seen))
+	}
+}
+
+/*
+TestDecoderStreamArrayCallbackError checks that an error from the callback stops iteration and is
+surfaced as a KeyError identifying the offending index
+*/
+func TestDecoderStreamArrayCallbackError(t *testing.T) {
+	dec := ezjson.NewDecoder(strings.NewReader(testDataString))
+
+	calls := 0
+	err := dec.StreamArray(func(idx int, v interface{}) error {
+		calls++
+		if idx == 1 {
+			return fmt.Errorf("boom")
+		}
+		return nil
+	}, "array")
+	if err == nil {
+		t.Fatal("expected error from callback")
+	}
+	keyErr, ok := err.(*ezjson.KeyError)
+	if !ok {
+		t.Fatalf("expected *ezjson.KeyError, got %T", err)
+	}
+	if keyErr.Idx != 1 {
+		t.Fatalf("expected Idx 1, got %d", keyErr.Idx)
+	}
+	if calls != 2 {
+		t.Fatalf("expected iteration to stop after 2 calls, got %d", calls)
+	}
+}
+
+/*
+TestDecoderStreamArrayMissingPath checks that seeking a non-existent path returns an error
+*/
+func TestDecoderStreamArrayMissingPath(t *testing.T) {
+	dec := ezjson.NewDecoder(strings.NewReader(testDataString))
+
+	err := dec.StreamArray(func(idx int, v interface{}) error {
+		return nil
+	}, "data", "inexistentArray")
+	if err == nil {
+		t.Fatal("expected error for non-existent path")
+	}
+}
@@ -0,0 +1,217 @@
+package ezjson
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+const (
+	// OptionCollectErrors - if this option is specified as a key to GetPropertyAll, wildcard
+	// traversal keeps visiting every remaining element/property after one of them errors, instead
+	// of stopping at the first error.
+	OptionCollectErrors Option = 2
+)
+
+// MultiError bundles a list of errors collected while tolerantly decoding or walking JSON data,
+// e.g. by DecodeBytesTolerant or GetPropertyAll.
+type MultiError struct {
+	Errors []error
+}
+
+func (e *MultiError) Error() string {
+	msgs := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		msgs[i] = err.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap allows errors.Is/errors.As to see through a MultiError to the errors it collected.
+func (e *MultiError) Unwrap() []error {
+	return e.Errors
+}
+
+// AsMultiError collapses the errs returned by DecodeBytesTolerant/DecodeReaderTolerant or
+// GetPropertyAll into a single *MultiError, or returns nil if errs is empty. Use this when only a
+// single error value is wanted, e.g. to return from a function that doesn't have room for a slice.
+func AsMultiError(errs []error) *MultiError {
+	if len(errs) == 0 {
+		return nil
+	}
+	return &MultiError{Errors: errs}
+}
+
+// decodeValueTolerant decodes the next JSON value from dec. Unlike a plain json.Decoder, if the
+// input is truncated or otherwise malformed partway through an object or array, it returns the
+// data collected so far instead of discarding it, and appends a *KeyError describing where it gave
+// up to errs. path is the key sequence leading to this value, used to annotate any such error.
+func decodeValueTolerant(dec *json.Decoder, path []interface{}, errs *[]error) (interface{}, error) {
+	tok, err := dec.Token()
+	if err != nil {
+		return nil, err
+	}
+	delim, isDelim := tok.(json.Delim)
+	if !isDelim {
+		return tok, nil
+	}
+
+	switch delim {
+	case '{':
+		m := map[string]interface{}{}
+		for dec.More() {
+			keyTok, err := dec.Token()
+			if err != nil {
+				*errs = append(*errs, &KeyError{fmt.Sprintf("truncated object: %s", err), len(path), "*"})
+				return m, nil
+			}
+			key, _ := keyTok.(string)
+			v, err := decodeValueTolerant(dec, append(path, key), errs)
+			if err != nil {
+				*errs = append(*errs, &KeyError{fmt.Sprintf("truncated value: %s", err), len(path), key})
+				return m, nil
+			}
+			m[key] = v
+		}
+		if _, err := dec.Token(); err != nil { // the closing '}'
+			*errs = append(*errs, &KeyError{fmt.Sprintf("truncated object: %s", err), len(path), "*"})
+		}
+		return m, nil
+	case '[':
+		var arr []interface{}
+		for idx := 0; dec.More(); idx++ {
+			v, err := decodeValueTolerant(dec, append(path, idx), errs)
+			if err != nil {
+				*errs = append(*errs, &KeyError{fmt.Sprintf("truncated value: %s", err), len(path), strconv.Itoa(idx)})
+				return arr, nil
+			}
+			arr = append(arr, v)
+		}
+		if _, err := dec.Token(); err != nil { // the closing ']'
+			*errs = append(*errs, &KeyError{fmt.Sprintf("truncated array: %s", err), len(path), "*"})
+		}
+		return arr, nil
+	}
+	return nil, nil
+}
+
+// DecodeBytesTolerant decodes JSON data from a byte array like DecodeBytes, but if the input is
+// truncated or malformed partway through a nested object or array, it returns the data decoded so
+// far along with a list of errors describing what was skipped, instead of discarding everything.
+// err is only set if not even the top-level value could be decoded.
+func DecodeBytesTolerant(cont []byte) (data interface{}, errs []error, err error) {
+	return DecodeReaderTolerant(bytes.NewReader(cont))
+}
+
+// DecodeStringTolerant decodes JSON data from a string, see DecodeBytesTolerant.
+func DecodeStringTolerant(cont string) (data interface{}, errs []error, err error) {
+	return DecodeBytesTolerant([]byte(cont))
+}
+
+// DecodeReaderTolerant decodes JSON data from an io.Reader, see DecodeBytesTolerant.
+func DecodeReaderTolerant(r io.Reader) (data interface{}, errs []error, err error) {
+	d := json.NewDecoder(r)
+	d.UseNumber()
+	data, err = decodeValueTolerant(d, nil, &errs)
+	return
+}
+
+// wildcardType is the type of Wildcard, the sentinel key value accepted by GetPropertyAll to mean
+// "every element of this array" or "every property of this object".
+type wildcardType struct{}
+
+// Wildcard is a sentinel key value for GetPropertyAll that matches every element of an array or
+// every property of an object at that nesting level.
+var Wildcard = wildcardType{}
+
+// stepOne resolves a single non-wildcard key against node, using the same semantics as
+// GetPropertyWithType, for use by GetPropertyAll's key walk.
+func stepOne(node interface{}, key interface{}, idx int) (interface{}, error) {
+	switch k := key.(type) {
+	case string:
+		m, isMap := node.(map[string]interface{})
+		if !isMap {
+			return nil, &KeyError{"No object found", idx, k}
+		}
+		v, ok := m[k]
+		if !ok {
+			return nil, &KeyError{"Object property not found", idx, k}
+		}
+		return v, nil
+	case int:
+		skey := strconv.Itoa(k)
+		a, isArray := node.([]interface{})
+		if !isArray {
+			return nil, &KeyError{"No array found", idx, skey}
+		}
+		if k < 0 || k >= len(a) {
+			return nil, &KeyError{"Array index out of bounds", idx, skey}
+		}
+		return a[k], nil
+	default:
+		return nil, &KeyError{"Not int or string", idx, fmt.Sprint("#v", k)}
+	}
+}
+
+// GetPropertyAll returns every value matching a key sequence containing one or more Wildcard
+// segments, along with a list of the errors encountered along the way (e.g. a non-conforming
+// sibling). By default, wildcard traversal stops at the first error in a branch; pass
+// OptionCollectErrors as a key (before the actual keys, like any other Option) to keep visiting
+// every remaining element/property instead.
+func GetPropertyAll(intf interface{}, keys ...interface{}) (res []interface{}, errs []error) {
+	collectErrors := false
+	realKeys := make([]interface{}, 0, len(keys))
+	for _, k := range keys {
+		if opt, ok := k.(Option); ok {
+			if opt == OptionCollectErrors {
+				collectErrors = true
+			}
+			continue
+		}
+		realKeys = append(realKeys, k)
+	}
+	return getPropertyAll(intf, realKeys, 0, collectErrors)
+}
+
+func getPropertyAll(node interface{}, keys []interface{}, idx int, collectErrors bool) ([]interface{}, []error) {
+	if idx == len(keys) {
+		return []interface{}{node}, nil
+	}
+
+	if _, isWildcard := keys[idx].(wildcardType); isWildcard {
+		var res []interface{}
+		var errs []error
+		appendBranch := func(v interface{}) bool {
+			subRes, subErrs := getPropertyAll(v, keys, idx+1, collectErrors)
+			res = append(res, subRes...)
+			errs = append(errs, subErrs...)
+			return len(subErrs) == 0 || collectErrors
+		}
+		switch n := node.(type) {
+		case []interface{}:
+			for _, v := range n {
+				if !appendBranch(v) {
+					break
+				}
+			}
+		case map[string]interface{}:
+			for _, v := range n {
+				if !appendBranch(v) {
+					break
+				}
+			}
+		default:
+			errs = append(errs, &KeyError{"No object or array found for wildcard", idx, "*"})
+		}
+		return res, errs
+	}
+
+	next, err := stepOne(node, keys[idx], idx)
+	if err != nil {
+		return nil, []error{err}
+	}
+	return getPropertyAll(next, keys, idx+1, collectErrors)
+}
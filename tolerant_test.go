@@ -0,0 +1,88 @@
+package ezjson_test
+
+import (
+	"testing"
+
+	"github.com/nieware/ezjson"
+)
+
+/*
+TestDecodeBytesTolerantTruncatedArray checks that a truncated array still yields the elements
+decoded so far, plus an error describing the truncation
+*/
+func TestDecodeBytesTolerantTruncatedArray(t *testing.T) {
+	data, errs, err := ezjson.DecodeStringTolerant(`{"items":[1,2,`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(errs) == 0 {
+		t.Fatal("expected at least one error for the truncated array")
+	}
+	items, getErr := ezjson.GetArray(data, "items")
+	if getErr != nil {
+		t.Fatal(getErr)
+	}
+	if len(items) != 2 {
+		t.Fatalf("expected 2 elements decoded before truncation, got %d", len(items))
+	}
+}
+
+/*
+TestAsMultiError checks that AsMultiError collapses collected errors into a single error value,
+and that it reports nil for an empty slice instead of a non-nil, empty error
+*/
+func TestAsMultiError(t *testing.T) {
+	if me := ezjson.AsMultiError(nil); me != nil {
+		t.Fatalf("expected nil for no errors, got %v", me)
+	}
+
+	_, errs, err := ezjson.DecodeStringTolerant(`{"items":[1,2,`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	me := ezjson.AsMultiError(errs)
+	if me == nil {
+		t.Fatal("expected a non-nil *MultiError")
+	}
+	if len(me.Errors) != len(errs) {
+		t.Fatalf("expected %d wrapped errors, got %d", len(errs), len(me.Errors))
+	}
+	if me.Error() == "" {
+		t.Fatal("expected a non-empty error message")
+	}
+}
+
+/*
+TestGetPropertyAllWildcard checks that GetPropertyAll collects one match per array element
+*/
+func TestGetPropertyAllWildcard(t *testing.T) {
+	testData, _ := ezjson.DecodeString(`{"items":[{"v":1},{"v":2},{"v":3}]}`)
+	res, errs := ezjson.GetPropertyAll(testData, "items", ezjson.Wildcard, "v")
+	if len(errs) != 0 {
+		t.Fatalf("expected no errors, got %v", errs)
+	}
+	if len(res) != 3 {
+		t.Fatalf("expected 3 matches, got %d", len(res))
+	}
+}
+
+/*
+TestGetPropertyAllCollectErrors checks the default stop-at-first-error behaviour versus
+OptionCollectErrors
+*/
+func TestGetPropertyAllCollectErrors(t *testing.T) {
+	testData, _ := ezjson.DecodeString(`{"items":[{"v":1},{},{"v":3}]}`)
+
+	_, errs := ezjson.GetPropertyAll(testData, "items", ezjson.Wildcard, "v")
+	if len(errs) != 1 {
+		t.Fatalf("expected traversal to stop after the first error, got %d errors", len(errs))
+	}
+
+	res, errs := ezjson.GetPropertyAll(testData, ezjson.OptionCollectErrors, "items", ezjson.Wildcard, "v")
+	if len(errs) != 1 {
+		t.Fatalf("expected 1 error collected across all branches, got %d", len(errs))
+	}
+	if len(res) != 2 {
+		t.Fatalf("expected 2 successful matches, got %d", len(res))
+	}
+}